@@ -0,0 +1,42 @@
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dnsimple/dnsimple-go/dnsimple"
+)
+
+// ListLinkedPrimaryServerIDs returns the IDs of the primary servers currently linked to zoneName. Unlike
+// the account's primary server roster, this is scoped to the zone, so it's safe to use for drift
+// detection and teardown without touching links that belong to other secondary zones.
+func ListLinkedPrimaryServerIDs(ctx context.Context, client *dnsimple.Client, accountID, zoneName string) ([]int64, error) {
+	response, err := client.SecondaryDNS.ListPrimaryServers(ctx, accountID, zoneName, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list primary servers linked to zone %q: %w", zoneName, err)
+	}
+
+	ids := make([]int64, 0, len(response.Data))
+	for _, server := range response.Data {
+		ids = append(ids, server.ID)
+	}
+
+	return ids, nil
+}
+
+// UnlinkAllPrimaryServers unlinks every primary server currently linked to zoneName. It's used to tear
+// down a secondary zone's primary server links before the zone itself is deleted.
+func UnlinkAllPrimaryServers(ctx context.Context, client *dnsimple.Client, accountID, zoneName string) error {
+	linkedIDs, err := ListLinkedPrimaryServerIDs(ctx, client, accountID, zoneName)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range linkedIDs {
+		if err := client.SecondaryDNS.UnlinkPrimaryServer(ctx, accountID, zoneName, id); err != nil {
+			return fmt.Errorf("failed to unlink primary server %d from zone %q: %w", id, zoneName, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/dnsimple/dnsimple-go/dnsimple"
+)
+
+// newTestSecondaryDNSServer fakes the per-zone primary server linkage endpoints for two zones with
+// overlapping primary server IDs, so a regression that scans the account's whole primary server roster
+// instead of the requested zone's own links shows up as a test failure rather than a silent
+// cross-zone bug in production.
+func newTestSecondaryDNSServer(t *testing.T) (client *dnsimple.Client, unlinkedFromExampleCom *[]int64) {
+	t.Helper()
+
+	linked := map[string][]int64{
+		"example.com": {1, 2},
+		"example.net": {2, 3},
+	}
+
+	var unlinked []int64
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/1/zones/example.com/secondary_dns/primary_servers", func(w http.ResponseWriter, r *http.Request) {
+		writePrimaryServerList(w, linked["example.com"])
+	})
+	mux.HandleFunc("/v2/1/zones/example.net/secondary_dns/primary_servers", func(w http.ResponseWriter, r *http.Request) {
+		writePrimaryServerList(w, linked["example.net"])
+	})
+	mux.HandleFunc("/v2/1/zones/example.com/secondary_dns/primary_servers/", func(w http.ResponseWriter, r *http.Request) {
+		var id int64
+		fmt.Sscanf(r.URL.Path, "/v2/1/zones/example.com/secondary_dns/primary_servers/%d", &id)
+		unlinked = append(unlinked, id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client = dnsimple.NewClient(http.DefaultClient)
+	client.BaseURL = server.URL
+
+	return client, &unlinked
+}
+
+func writePrimaryServerList(w http.ResponseWriter, ids []int64) {
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprint(w, `{"data":[`)
+	for i, id := range ids {
+		if i > 0 {
+			fmt.Fprint(w, ",")
+		}
+		fmt.Fprintf(w, `{"id":%d}`, id)
+	}
+	fmt.Fprint(w, `]}`)
+}
+
+func TestListLinkedPrimaryServerIDsIsScopedToTheZone(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newTestSecondaryDNSServer(t)
+
+	cases := map[string][]int64{
+		"example.com": {1, 2},
+		"example.net": {2, 3},
+	}
+
+	for zoneName, want := range cases {
+		got, err := ListLinkedPrimaryServerIDs(ctx, client, "1", zoneName)
+		if err != nil {
+			t.Fatalf("ListLinkedPrimaryServerIDs(%q) returned an error: %v", zoneName, err)
+		}
+
+		sort.Slice(got, func(i, j int) bool { return got[i] < got[j] })
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("ListLinkedPrimaryServerIDs(%q) = %v, want %v", zoneName, got, want)
+		}
+	}
+}
+
+func TestUnlinkAllPrimaryServersOnlyTouchesTheRequestedZone(t *testing.T) {
+	ctx := context.Background()
+	client, unlinked := newTestSecondaryDNSServer(t)
+
+	if err := UnlinkAllPrimaryServers(ctx, client, "1", "example.com"); err != nil {
+		t.Fatalf("UnlinkAllPrimaryServers returned an error: %v", err)
+	}
+
+	got := append([]int64(nil), (*unlinked)...)
+	sort.Slice(got, func(i, j int) bool { return got[i] < got[j] })
+
+	want := []int64{1, 2}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("unlinked primary IDs = %v, want only example.com's own %v (not example.net's primary 3)", got, want)
+	}
+}
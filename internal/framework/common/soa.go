@@ -0,0 +1,202 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dnsimple/dnsimple-go/dnsimple"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ZoneSOAModel describes the SOA record parameters that are shared between the primary and secondary
+// zone resources, so it's embedded in both rather than redeclared.
+type ZoneSOAModel struct {
+	TTL     types.Int64 `tfsdk:"ttl"`
+	Refresh types.Int64 `tfsdk:"refresh"`
+	Retry   types.Int64 `tfsdk:"retry"`
+	Expiry  types.Int64 `tfsdk:"expiry"`
+	NxTTL   types.Int64 `tfsdk:"nx_ttl"`
+}
+
+// ZoneSOASchemaAttributes returns the optional+computed schema attributes for ZoneSOAModel, to be merged
+// into the attribute maps of resources that embed it.
+func ZoneSOASchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"ttl": schema.Int64Attribute{
+			MarkdownDescription: "The TTL, in seconds, of the zone's SOA record.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+		"refresh": schema.Int64Attribute{
+			MarkdownDescription: "The SOA refresh interval, in seconds, telling secondary servers how often to check the primary for updates.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+		"retry": schema.Int64Attribute{
+			MarkdownDescription: "The SOA retry interval, in seconds, before a secondary server retries a failed zone refresh.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+		"expiry": schema.Int64Attribute{
+			MarkdownDescription: "The SOA expire interval, in seconds, after which a secondary server stops answering for the zone if it can't reach the primary.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+		"nx_ttl": schema.Int64Attribute{
+			MarkdownDescription: "The SOA MINIMUM field, in seconds, used as the negative-caching TTL per RFC 2308.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+	}
+}
+
+// FetchSOARecord looks up the apex SOA record for a zone.
+func FetchSOARecord(ctx context.Context, client *dnsimple.Client, accountID, zoneName string) (*dnsimple.ZoneRecord, error) {
+	response, err := client.Zones.ListRecords(ctx, accountID, zoneName, &dnsimple.ZoneRecordListOptions{
+		Name: dnsimple.String(""),
+		Type: dnsimple.String("SOA"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range response.Data {
+		record := record
+		if record.Type == "SOA" {
+			return &record, nil
+		}
+	}
+
+	return nil, fmt.Errorf("zone %q has no SOA record", zoneName)
+}
+
+// ReadSOAIntoModel populates a ZoneSOAModel from the current state of the zone's SOA record.
+func ReadSOAIntoModel(record *dnsimple.ZoneRecord, model *ZoneSOAModel) error {
+	_, _, _, refresh, retry, expire, minimum, err := ParseSOAContent(record.Content)
+	if err != nil {
+		return err
+	}
+
+	model.TTL = types.Int64Value(int64(record.TTL))
+	model.Refresh = types.Int64Value(refresh)
+	model.Retry = types.Int64Value(retry)
+	model.Expiry = types.Int64Value(expire)
+	model.NxTTL = types.Int64Value(minimum)
+
+	return nil
+}
+
+// ApplySOAModel updates the zone's SOA record with any non-null fields set on model, leaving the rest of
+// the record untouched, and returns the record as it stands after the update.
+func ApplySOAModel(ctx context.Context, client *dnsimple.Client, accountID, zoneName string, record *dnsimple.ZoneRecord, model *ZoneSOAModel) (*dnsimple.ZoneRecord, error) {
+	mname, rname, serial, refresh, retry, expire, minimum, err := ParseSOAContent(record.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	if !model.Refresh.IsUnknown() && !model.Refresh.IsNull() {
+		refresh = model.Refresh.ValueInt64()
+	}
+	if !model.Retry.IsUnknown() && !model.Retry.IsNull() {
+		retry = model.Retry.ValueInt64()
+	}
+	if !model.Expiry.IsUnknown() && !model.Expiry.IsNull() {
+		expire = model.Expiry.ValueInt64()
+	}
+	if !model.NxTTL.IsUnknown() && !model.NxTTL.IsNull() {
+		minimum = model.NxTTL.ValueInt64()
+	}
+
+	ttl := record.TTL
+	if !model.TTL.IsUnknown() && !model.TTL.IsNull() {
+		ttl = int(model.TTL.ValueInt64())
+	}
+
+	response, err := client.Zones.UpdateRecord(ctx, accountID, zoneName, record.ID, dnsimple.ZoneRecordAttributes{
+		TTL:     ttl,
+		Content: FormatSOAContent(mname, rname, serial, refresh, retry, expire, minimum),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Data, nil
+}
+
+// ReconcileZoneSOA applies any SOA parameters set on model to the zone's SOA record, then reads the
+// record back so model reflects the values DNSimple actually stored (defaults included). It's shared by
+// the primary and secondary zone resources' Create/Update.
+func ReconcileZoneSOA(ctx context.Context, client *dnsimple.Client, accountID, zoneName string, model *ZoneSOAModel) (diags diag.Diagnostics) {
+	soaRecord, err := FetchSOARecord(ctx, client, accountID, zoneName)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("failed to read SOA record for DNSimple zone %q", zoneName),
+			err.Error(),
+		)
+		return
+	}
+
+	soaRecord, err = ApplySOAModel(ctx, client, accountID, zoneName, soaRecord, model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("failed to update SOA record for DNSimple zone %q", zoneName),
+			err.Error(),
+		)
+		return
+	}
+
+	if err := ReadSOAIntoModel(soaRecord, model); err != nil {
+		diags.AddError(
+			fmt.Sprintf("failed to parse SOA record for DNSimple zone %q", zoneName),
+			err.Error(),
+		)
+	}
+
+	return
+}
+
+// ParseSOAContent splits an SOA record's content into its constituent fields per RFC 1035 section 3.3.13.
+func ParseSOAContent(content string) (mname, rname string, serial, refresh, retry, expire, minimum int64, err error) {
+	fields := strings.Fields(content)
+	if len(fields) != 7 {
+		err = fmt.Errorf("unexpected SOA record content %q", content)
+		return
+	}
+
+	mname, rname = fields[0], fields[1]
+
+	values := make([]int64, 5)
+	for i, field := range fields[2:] {
+		values[i], err = strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			err = fmt.Errorf("unexpected SOA record content %q: %w", content, err)
+			return
+		}
+	}
+
+	serial, refresh, retry, expire, minimum = values[0], values[1], values[2], values[3], values[4]
+	return
+}
+
+// FormatSOAContent renders an SOA record's content from its constituent fields.
+func FormatSOAContent(mname, rname string, serial, refresh, retry, expire, minimum int64) string {
+	return fmt.Sprintf("%s %s %d %d %d %d %d", mname, rname, serial, refresh, retry, expire, minimum)
+}
+
+// SOAHostmaster converts an SOA RNAME (e.g. "admin.example.com.") into its hostmaster email form
+// (e.g. "admin@example.com"), per RFC 1035 section 3.3.13.
+func SOAHostmaster(rname string) string {
+	hostmaster := strings.TrimSuffix(rname, ".")
+	return strings.Replace(hostmaster, ".", "@", 1)
+}
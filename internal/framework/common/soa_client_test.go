@@ -0,0 +1,112 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dnsimple/dnsimple-go/dnsimple"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func readJSON(r *http.Request, v interface{}) error {
+	defer r.Body.Close()
+	return json.NewDecoder(r.Body).Decode(v)
+}
+
+// newTestSOAServer fakes just enough of the Zones records API for FetchSOARecord/ApplySOAModel to
+// exercise real HTTP round-trips: a list endpoint returning a single SOA record, and an update endpoint
+// that records what it was asked to change and echoes it back.
+func newTestSOAServer(t *testing.T, initialContent string, initialTTL int) (*dnsimple.Client, *dnsimple.ZoneRecordAttributes) {
+	t.Helper()
+
+	var lastUpdate dnsimple.ZoneRecordAttributes
+	content, ttl := initialContent, initialTTL
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/1/zones/example.com/records", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data":[{"id":1,"zone_id":"example.com","type":"SOA","name":"","content":%q,"ttl":%d}]}`, content, ttl)
+	})
+	mux.HandleFunc("/v2/1/zones/example.com/records/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Fatalf("unexpected method %s for record update", r.Method)
+		}
+
+		var attrs dnsimple.ZoneRecordAttributes
+		if err := readJSON(r, &attrs); err != nil {
+			t.Fatalf("failed to decode update request body: %v", err)
+		}
+		lastUpdate = attrs
+		content, ttl = attrs.Content, attrs.TTL
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data":{"id":1,"zone_id":"example.com","type":"SOA","name":"","content":%q,"ttl":%d}}`, content, ttl)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := dnsimple.NewClient(http.DefaultClient)
+	client.BaseURL = server.URL
+
+	return client, &lastUpdate
+}
+
+func TestApplySOAModelOnlyChangesSetFields(t *testing.T) {
+	ctx := context.Background()
+
+	const originalContent = "ns1.dnsimple.com. admin.example.com. 1 86400 7200 604800 300"
+	client, lastUpdate := newTestSOAServer(t, originalContent, 3600)
+
+	record, err := FetchSOARecord(ctx, client, "1", "example.com")
+	if err != nil {
+		t.Fatalf("FetchSOARecord returned an error: %v", err)
+	}
+
+	model := &ZoneSOAModel{
+		Retry: types.Int64Value(1800),
+		// TTL, Refresh, Expiry, and NxTTL are left null/unknown and should be preserved untouched.
+	}
+
+	updated, err := ApplySOAModel(ctx, client, "1", "example.com", record, model)
+	if err != nil {
+		t.Fatalf("ApplySOAModel returned an error: %v", err)
+	}
+
+	wantContent := "ns1.dnsimple.com. admin.example.com. 1 86400 1800 604800 300"
+	if updated.Content != wantContent {
+		t.Errorf("updated content = %q, want %q", updated.Content, wantContent)
+	}
+	if lastUpdate.TTL != 3600 {
+		t.Errorf("updated TTL = %d, want the original 3600 to be preserved", lastUpdate.TTL)
+	}
+}
+
+func TestReconcileZoneSOARoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	const originalContent = "ns1.dnsimple.com. admin.example.com. 1 86400 7200 604800 300"
+	client, _ := newTestSOAServer(t, originalContent, 3600)
+
+	model := &ZoneSOAModel{
+		NxTTL: types.Int64Value(60),
+	}
+
+	if diags := ReconcileZoneSOA(ctx, client, "1", "example.com", model); diags.HasError() {
+		t.Fatalf("ReconcileZoneSOA diagnostics: %+v", diags)
+	}
+
+	if model.NxTTL.ValueInt64() != 60 {
+		t.Errorf("NxTTL = %d, want 60", model.NxTTL.ValueInt64())
+	}
+	if model.Refresh.ValueInt64() != 86400 {
+		t.Errorf("Refresh = %d, want the unchanged 86400 to be reflected back", model.Refresh.ValueInt64())
+	}
+	if model.TTL.ValueInt64() != 3600 {
+		t.Errorf("TTL = %d, want the unchanged 3600 to be reflected back", model.TTL.ValueInt64())
+	}
+}
@@ -0,0 +1,53 @@
+package common
+
+import "testing"
+
+func TestParseAndFormatSOAContentRoundTrip(t *testing.T) {
+	content := "ns1.dnsimple.com. admin.example.com. 2026072601 86400 7200 604800 300"
+
+	mname, rname, serial, refresh, retry, expire, minimum, err := ParseSOAContent(content)
+	if err != nil {
+		t.Fatalf("ParseSOAContent returned an error: %v", err)
+	}
+
+	if mname != "ns1.dnsimple.com." {
+		t.Errorf("mname = %q, want %q", mname, "ns1.dnsimple.com.")
+	}
+	if rname != "admin.example.com." {
+		t.Errorf("rname = %q, want %q", rname, "admin.example.com.")
+	}
+	if serial != 2026072601 {
+		t.Errorf("serial = %d, want %d", serial, 2026072601)
+	}
+	if refresh != 86400 || retry != 7200 || expire != 604800 || minimum != 300 {
+		t.Errorf("refresh/retry/expire/minimum = %d/%d/%d/%d, want 86400/7200/604800/300", refresh, retry, expire, minimum)
+	}
+
+	if got := FormatSOAContent(mname, rname, serial, refresh, retry, expire, minimum); got != content {
+		t.Errorf("FormatSOAContent round-trip = %q, want %q", got, content)
+	}
+}
+
+func TestParseSOAContentInvalid(t *testing.T) {
+	if _, _, _, _, _, _, _, err := ParseSOAContent("not a valid SOA record"); err == nil {
+		t.Error("expected an error for content with the wrong number of fields, got nil")
+	}
+
+	if _, _, _, _, _, _, _, err := ParseSOAContent("ns1.dnsimple.com. admin.example.com. not-a-number 86400 7200 604800 300"); err == nil {
+		t.Error("expected an error for a non-numeric field, got nil")
+	}
+}
+
+func TestSOAHostmaster(t *testing.T) {
+	cases := map[string]string{
+		"admin.example.com.": "admin@example.com",
+		"admin.example.com":  "admin@example.com",
+		"a.b.example.com.":   "a@b.example.com",
+	}
+
+	for rname, want := range cases {
+		if got := SOAHostmaster(rname); got != want {
+			t.Errorf("SOAHostmaster(%q) = %q, want %q", rname, got, want)
+		}
+	}
+}
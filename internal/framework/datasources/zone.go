@@ -0,0 +1,192 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dnsimple/dnsimple-go/dnsimple"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ZoneDataSource{}
+	_ datasource.DataSourceWithConfigure = &ZoneDataSource{}
+)
+
+func NewZoneDataSource() datasource.DataSource {
+	return &ZoneDataSource{}
+}
+
+// ZoneDataSource defines the data source implementation.
+type ZoneDataSource struct {
+	config *common.DnsimpleProviderConfig
+}
+
+// ZoneDataSourceModel describes the data source data model.
+type ZoneDataSourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	ID          types.Int64  `tfsdk:"id"`
+	AccountID   types.Int64  `tfsdk:"account_id"`
+	Reverse     types.Bool   `tfsdk:"reverse"`
+	Secondary   types.Bool   `tfsdk:"secondary"`
+	NameServers types.List   `tfsdk:"name_servers"`
+	Hostmaster  types.String `tfsdk:"hostmaster"`
+	Serial      types.Int64  `tfsdk:"serial"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+func (d *ZoneDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone"
+}
+
+func (d *ZoneDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides details about a DNSimple zone, whether primary or secondary, including the name servers DNSimple has assigned to it.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the zone.",
+				Required:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the zone.",
+				Computed:            true,
+			},
+			"account_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the account the zone belongs to.",
+				Computed:            true,
+			},
+			"reverse": schema.BoolAttribute{
+				MarkdownDescription: "Whether the zone is a reverse DNS zone.",
+				Computed:            true,
+			},
+			"secondary": schema.BoolAttribute{
+				MarkdownDescription: "Whether the zone is a secondary zone.",
+				Computed:            true,
+			},
+			"name_servers": schema.ListAttribute{
+				MarkdownDescription: "The name servers DNSimple has assigned to the zone.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"hostmaster": schema.StringAttribute{
+				MarkdownDescription: "The zone's SOA hostmaster email address, derived from the SOA record's RNAME field.",
+				Computed:            true,
+			},
+			"serial": schema.Int64Attribute{
+				MarkdownDescription: "The zone's current SOA serial number.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "When the zone was created, in ISO 8601 format.",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "When the zone was last updated, in ISO 8601 format.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ZoneDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*common.DnsimpleProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.DnsimpleProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.config = config
+}
+
+func (d *ZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *ZoneDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneName := data.Name.ValueString()
+
+	zoneResponse, err := d.config.Client.Zones.GetZone(ctx, d.config.AccountID, zoneName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed to read DNSimple zone %q", zoneName),
+			err.Error(),
+		)
+		return
+	}
+
+	zone := zoneResponse.Data
+	data.ID = types.Int64Value(zone.ID)
+	data.AccountID = types.Int64Value(zone.AccountID)
+	data.Reverse = types.BoolValue(zone.Reverse)
+	data.Secondary = types.BoolValue(zone.Secondary)
+	data.CreatedAt = types.StringValue(zone.CreatedAt)
+	data.UpdatedAt = types.StringValue(zone.UpdatedAt)
+
+	nsRecordsResponse, err := d.config.Client.Zones.ListRecords(ctx, d.config.AccountID, zoneName, &dnsimple.ZoneRecordListOptions{
+		Name: dnsimple.String(""),
+		Type: dnsimple.String("NS"),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed to read name servers for DNSimple zone %q", zoneName),
+			err.Error(),
+		)
+		return
+	}
+
+	nameServers := make([]attr.Value, 0, len(nsRecordsResponse.Data))
+	for _, record := range nsRecordsResponse.Data {
+		nameServers = append(nameServers, types.StringValue(record.Content))
+	}
+
+	nameServersList, diags := types.ListValue(types.StringType, nameServers)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.NameServers = nameServersList
+
+	soaRecord, err := common.FetchSOARecord(ctx, d.config.Client, d.config.AccountID, zoneName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed to read SOA record for DNSimple zone %q", zoneName),
+			err.Error(),
+		)
+		return
+	}
+
+	_, rname, serial, _, _, _, _, err := common.ParseSOAContent(soaRecord.Content)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed to parse SOA record for DNSimple zone %q", zoneName),
+			err.Error(),
+		)
+		return
+	}
+
+	data.Hostmaster = types.StringValue(common.SOAHostmaster(rname))
+	data.Serial = types.Int64Value(serial)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,97 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dnsimple/dnsimple-go/dnsimple"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+)
+
+func TestZoneDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+	schemaRequest := datasource.SchemaRequest{}
+	schemaResponse := &datasource.SchemaResponse{}
+
+	NewZoneDataSource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestZoneDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/1/zones/example.com", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data":{"id":42,"account_id":1,"name":"example.com","reverse":false,"secondary":false,"created_at":"2023-01-01T00:00:00Z","updated_at":"2023-01-01T00:00:00Z"}}`)
+	})
+	mux.HandleFunc("/v2/1/zones/example.com/records", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("type") == "NS" {
+			fmt.Fprint(w, `{"data":[{"id":1,"type":"NS","content":"ns1.dnsimple.com"},{"id":2,"type":"NS","content":"ns2.dnsimple.com"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"data":[{"id":3,"type":"SOA","content":"ns1.dnsimple.com. admin.example.com. 5 86400 7200 604800 300","ttl":3600}]}`)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := dnsimple.NewClient(http.DefaultClient)
+	client.BaseURL = server.URL
+
+	d := &ZoneDataSource{config: &common.DnsimpleProviderConfig{Client: client, AccountID: "1"}}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	model := ZoneDataSourceModel{Name: types.StringValue("example.com")}
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := config.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("config.Set diagnostics: %+v", diags)
+	}
+
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+	}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read diagnostics: %+v", resp.Diagnostics)
+	}
+
+	var result ZoneDataSourceModel
+	if diags := resp.State.Get(ctx, &result); diags.HasError() {
+		t.Fatalf("State.Get diagnostics: %+v", diags)
+	}
+
+	if result.ID.ValueInt64() != 42 {
+		t.Errorf("ID = %d, want 42", result.ID.ValueInt64())
+	}
+	if result.Serial.ValueInt64() != 5 {
+		t.Errorf("Serial = %d, want 5", result.Serial.ValueInt64())
+	}
+	if result.Hostmaster.ValueString() != "admin@example.com" {
+		t.Errorf("Hostmaster = %q, want %q", result.Hostmaster.ValueString(), "admin@example.com")
+	}
+
+	var nameServers []string
+	result.NameServers.ElementsAs(ctx, &nameServers, false)
+	if fmt.Sprint(nameServers) != fmt.Sprint([]string{"ns1.dnsimple.com", "ns2.dnsimple.com"}) {
+		t.Errorf("NameServers = %v, want [ns1.dnsimple.com ns2.dnsimple.com]", nameServers)
+	}
+}
@@ -6,10 +6,13 @@ import (
 	"fmt"
 
 	"github.com/dnsimple/dnsimple-go/dnsimple"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
 	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/utils"
 )
@@ -32,8 +35,11 @@ type DomainSecondaryZoneResource struct {
 
 // DomainSecondaryZoneResourceModel describes the resource data model.
 type DomainSecondaryZoneResourceModel struct {
-	Name types.String `tfsdk:"name"`
-	ID types.Int64 `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	ID                  types.Int64  `tfsdk:"id"`
+	DeleteZoneOnDestroy types.Bool   `tfsdk:"delete_zone_on_destroy"`
+	LinkedPrimaryIDs    types.List   `tfsdk:"linked_primary_ids"`
+	common.ZoneSOAModel
 }
 
 func (r *DomainSecondaryZoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -41,17 +47,34 @@ func (r *DomainSecondaryZoneResource) Metadata(ctx context.Context, req resource
 }
 
 func (r *DomainSecondaryZoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Required: true,
+		},
+		"id": schema.Int64Attribute{
+			// For most of the APIs, domain name is the primary key exposed, rather than the ID
+			Computed: true,
+		},
+		"delete_zone_on_destroy": schema.BoolAttribute{
+			MarkdownDescription: "Whether destroying this resource should also delete the zone itself via the DNSimple zones API. Defaults to `false`, which leaves the zone (and its records) in place after unlinking its primary servers.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"linked_primary_ids": schema.ListAttribute{
+			MarkdownDescription: "The IDs of the primary servers currently linked to this zone. Reconciled on every `terraform apply`/`terraform refresh` against the `dnsimple_domain_secondary_zone_primary` links that actually exist, so it reflects drift from links created or removed outside this resource.",
+			ElementType:         types.Int64Type,
+			Computed:            true,
+		},
+	}
+
+	for name, attribute := range common.ZoneSOASchemaAttributes() {
+		attributes[name] = attribute
+	}
+
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "DNSimple domain secondary zone resource",
-		Attributes: map[string]schema.Attribute{
-			"name": schema.StringAttribute{
-				Required: true,
-			},
-			"id": schema.Int64Attribute{
-				// For most of the APIs, domain name is the primary key exposed, rather than the ID
-				Computed: true,
-			},
-		},
+		Attributes:          attributes,
 	}
 }
 
@@ -97,9 +120,19 @@ func (r *DomainSecondaryZoneResource) Create(ctx context.Context, req resource.C
 			"failed to create DNSimple secondary DNS zone",
 			err.Error(),
 		)
+		return
 	}
 
 	r.updateModelFromAPIResponse(&response.Data.Zone, data)
+
+	if resp.Diagnostics.Append(r.reconcileSOA(ctx, data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if resp.Diagnostics.Append(r.reconcileLinkedPrimaryIDs(ctx, data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -130,19 +163,155 @@ func (r *DomainSecondaryZoneResource) Read(ctx context.Context, req resource.Rea
 	// by making our handling of the "this zone was already created" error idempotent.
 
 	r.updateModelFromAPIResponse(zoneResponse.Data, data)
+
+	soaRecord, err := common.FetchSOARecord(ctx, r.config.Client, r.config.AccountID, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed to read SOA record for DNSimple zone %q", data.Name.ValueString()),
+			err.Error(),
+		)
+		return
+	}
+
+	if err := common.ReadSOAIntoModel(soaRecord, &data.ZoneSOAModel); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed to parse SOA record for DNSimple zone %q", data.Name.ValueString()),
+			err.Error(),
+		)
+		return
+	}
+
+	if resp.Diagnostics.Append(r.reconcileLinkedPrimaryIDs(ctx, data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *DomainSecondaryZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// No-op
+	var data *DomainSecondaryZoneResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if resp.Diagnostics.Append(r.reconcileSOA(ctx, data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if resp.Diagnostics.Append(r.reconcileLinkedPrimaryIDs(ctx, data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// reconcileSOA applies any SOA parameters set on data to the zone's SOA record, then reads the record
+// back so data reflects the values DNSimple actually stored (defaults included).
+func (r *DomainSecondaryZoneResource) reconcileSOA(ctx context.Context, data *DomainSecondaryZoneResourceModel) diag.Diagnostics {
+	return common.ReconcileZoneSOA(ctx, r.config.Client, r.config.AccountID, data.Name.ValueString(), &data.ZoneSOAModel)
+}
+
+// reconcileLinkedPrimaryIDs refreshes linked_primary_ids from the primary servers actually linked to the
+// zone, so drift from dnsimple_domain_secondary_zone_primary resources created or destroyed outside this
+// resource shows up on the next plan instead of going stale.
+func (r *DomainSecondaryZoneResource) reconcileLinkedPrimaryIDs(ctx context.Context, data *DomainSecondaryZoneResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	zoneName := data.Name.ValueString()
+
+	linkedIDs, err := common.ListLinkedPrimaryServerIDs(ctx, r.config.Client, r.config.AccountID, zoneName)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("failed to list primary servers linked to DNSimple secondary zone %q", zoneName),
+			err.Error(),
+		)
+		return diags
+	}
+
+	ids := make([]attr.Value, 0, len(linkedIDs))
+	for _, id := range linkedIDs {
+		ids = append(ids, types.Int64Value(id))
+	}
+
+	linkedPrimaryIDs, listDiags := types.ListValue(types.Int64Type, ids)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.LinkedPrimaryIDs = linkedPrimaryIDs
+
+	return diags
 }
 
 func (r *DomainSecondaryZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// No-op; DNSimple's secondary DNS zone API has no "delete secondary zone" API, only a "delete zone" API
+	var data *DomainSecondaryZoneResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneName := data.Name.ValueString()
+
+	// DNSimple's secondary DNS API has no "delete secondary zone" API, only a "delete zone" API, so the
+	// best we can do on destroy is unlink the zone's primary servers and, if the user opted in, delete
+	// the underlying zone outright.
+	if err := common.UnlinkAllPrimaryServers(ctx, r.config.Client, r.config.AccountID, zoneName); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed to unlink primary servers from DNSimple secondary zone %q", zoneName),
+			err.Error(),
+		)
+		return
+	}
+
+	if !data.DeleteZoneOnDestroy.ValueBool() {
+		return
+	}
+
+	if err := r.config.Client.Zones.DeleteZone(ctx, r.config.AccountID, zoneName); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed to delete DNSimple zone %q", zoneName),
+			err.Error(),
+		)
+	}
 }
 
 func (r *DomainSecondaryZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Populate linked_primary_ids from the primary servers actually linked to this zone, so the imported
+	// state accurately reflects it and subsequent plans don't spuriously want to recreate it.
+	linkedIDs, err := common.ListLinkedPrimaryServerIDs(ctx, r.config.Client, r.config.AccountID, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed to list primary servers linked to DNSimple secondary zone %q", req.ID),
+			err.Error(),
+		)
+		return
+	}
+
+	ids := make([]attr.Value, 0, len(linkedIDs))
+	for _, id := range linkedIDs {
+		ids = append(ids, types.Int64Value(id))
+	}
+
+	linkedPrimaryIDs, diags := types.ListValue(types.Int64Type, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("linked_primary_ids"), linkedPrimaryIDs)...)
 }
 
 func (r *DomainSecondaryZoneResource) updateModelFromAPIResponse(server *dnsimple.Zone, data *DomainSecondaryZoneResourceModel) {
@@ -0,0 +1,220 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dnsimple/dnsimple-go/dnsimple"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+)
+
+func TestDomainSecondaryZoneResourceSchema(t *testing.T) {
+	ctx := context.Background()
+	schemaRequest := resource.SchemaRequest{}
+	schemaResponse := &resource.SchemaResponse{}
+
+	NewDomainSecondaryZoneResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestDomainSecondaryZoneResourceReconcileSOAOnlyChangesSetFields(t *testing.T) {
+	ctx := context.Background()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/1/zones/example.com/records", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data":[{"id":1,"zone_id":"example.com","type":"SOA","name":"","content":"ns1.dnsimple.com. admin.example.com. 1 86400 7200 604800 300","ttl":3600}]}`)
+	})
+	mux.HandleFunc("/v2/1/zones/example.com/records/1", func(w http.ResponseWriter, r *http.Request) {
+		var attrs dnsimple.ZoneRecordAttributes
+		if err := json.NewDecoder(r.Body).Decode(&attrs); err != nil {
+			t.Errorf("failed to decode update request body: %v", err)
+		}
+		if want := "ns1.dnsimple.com. admin.example.com. 1 86400 7200 604800 120"; attrs.Content != want {
+			t.Errorf("update request content = %q, want %q (only nx_ttl changed)", attrs.Content, want)
+		}
+		if attrs.TTL != 3600 {
+			t.Errorf("update request TTL = %d, want the original 3600 to be preserved", attrs.TTL)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data":{"id":1,"zone_id":"example.com","type":"SOA","name":"","content":"ns1.dnsimple.com. admin.example.com. 1 86400 7200 604800 120","ttl":3600}}`)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := dnsimple.NewClient(http.DefaultClient)
+	client.BaseURL = server.URL
+
+	r := &DomainSecondaryZoneResource{config: &common.DnsimpleProviderConfig{Client: client, AccountID: "1"}}
+
+	data := &DomainSecondaryZoneResourceModel{
+		Name:  types.StringValue("example.com"),
+		NxTTL: types.Int64Value(120),
+	}
+
+	if diags := r.reconcileSOA(ctx, data); diags.HasError() {
+		t.Fatalf("reconcileSOA diagnostics: %+v", diags)
+	}
+
+	if data.NxTTL.ValueInt64() != 120 {
+		t.Errorf("NxTTL = %d, want 120", data.NxTTL.ValueInt64())
+	}
+	if data.Refresh.ValueInt64() != 86400 {
+		t.Errorf("Refresh = %d, want the unchanged 86400 to be reflected back", data.Refresh.ValueInt64())
+	}
+}
+
+func TestDomainSecondaryZoneResourceReadReconcilesLinkedPrimaryIDs(t *testing.T) {
+	ctx := context.Background()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/1/zones/example.com", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data":{"id":1,"name":"example.com"}}`)
+	})
+	mux.HandleFunc("/v2/1/zones/example.com/records", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data":[{"id":1,"zone_id":"example.com","type":"SOA","name":"","content":"ns1.dnsimple.com. admin.example.com. 1 86400 7200 604800 300","ttl":3600}]}`)
+	})
+	mux.HandleFunc("/v2/1/zones/example.com/secondary_dns/primary_servers", func(w http.ResponseWriter, r *http.Request) {
+		// Simulates a primary server having been linked outside this resource (e.g. via
+		// dnsimple_domain_secondary_zone_primary) since the last time state was refreshed.
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data":[{"id":3}]}`)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := dnsimple.NewClient(http.DefaultClient)
+	client.BaseURL = server.URL
+
+	r := &DomainSecondaryZoneResource{config: &common.DnsimpleProviderConfig{Client: client, AccountID: "1"}}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	model := DomainSecondaryZoneResourceModel{
+		Name:             types.StringValue("example.com"),
+		ID:               types.Int64Value(1),
+		LinkedPrimaryIDs: types.ListValueMust(types.Int64Type, []attr.Value{}),
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("state.Set diagnostics: %+v", diags)
+	}
+
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read diagnostics: %+v", resp.Diagnostics)
+	}
+
+	var result DomainSecondaryZoneResourceModel
+	if diags := resp.State.Get(ctx, &result); diags.HasError() {
+		t.Fatalf("State.Get diagnostics: %+v", diags)
+	}
+
+	var linkedIDs []int64
+	result.LinkedPrimaryIDs.ElementsAs(ctx, &linkedIDs, false)
+	if fmt.Sprint(linkedIDs) != fmt.Sprint([]int64{3}) {
+		t.Errorf("LinkedPrimaryIDs = %v, want [3] reconciled from the live API instead of the stale empty state value", linkedIDs)
+	}
+}
+
+func TestDomainSecondaryZoneResourceDelete(t *testing.T) {
+	for _, deleteZoneOnDestroy := range []bool{false, true} {
+		t.Run(fmt.Sprintf("delete_zone_on_destroy=%v", deleteZoneOnDestroy), func(t *testing.T) {
+			ctx := context.Background()
+
+			var zoneDeleted bool
+			var unlinked []int64
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/v2/1/zones/example.com/secondary_dns/primary_servers", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `{"data":[{"id":1},{"id":2}]}`)
+			})
+			mux.HandleFunc("/v2/1/zones/example.com/secondary_dns/primary_servers/", func(w http.ResponseWriter, r *http.Request) {
+				var id int64
+				fmt.Sscanf(r.URL.Path, "/v2/1/zones/example.com/secondary_dns/primary_servers/%d", &id)
+				unlinked = append(unlinked, id)
+				w.WriteHeader(http.StatusNoContent)
+			})
+			mux.HandleFunc("/v2/1/zones/example.com", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete {
+					t.Fatalf("unexpected method %s for zone deletion", r.Method)
+				}
+				zoneDeleted = true
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client := dnsimple.NewClient(http.DefaultClient)
+			client.BaseURL = server.URL
+
+			r := &DomainSecondaryZoneResource{config: &common.DnsimpleProviderConfig{Client: client, AccountID: "1"}}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+			model := DomainSecondaryZoneResourceModel{
+				Name:                types.StringValue("example.com"),
+				ID:                  types.Int64Value(1),
+				DeleteZoneOnDestroy: types.BoolValue(deleteZoneOnDestroy),
+				LinkedPrimaryIDs:    types.ListNull(types.Int64Type),
+				ZoneSOAModel: common.ZoneSOAModel{
+					TTL:     types.Int64Null(),
+					Refresh: types.Int64Null(),
+					Retry:   types.Int64Null(),
+					Expiry:  types.Int64Null(),
+					NxTTL:   types.Int64Null(),
+				},
+			}
+
+			state := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			if diags := state.Set(ctx, &model); diags.HasError() {
+				t.Fatalf("state.Set diagnostics: %+v", diags)
+			}
+
+			resp := &resource.DeleteResponse{}
+			r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Delete diagnostics: %+v", resp.Diagnostics)
+			}
+
+			if len(unlinked) != 2 {
+				t.Errorf("unlinked %d primary servers, want 2", len(unlinked))
+			}
+
+			if zoneDeleted != deleteZoneOnDestroy {
+				t.Errorf("zone deleted = %v, want %v", zoneDeleted, deleteZoneOnDestroy)
+			}
+		})
+	}
+}
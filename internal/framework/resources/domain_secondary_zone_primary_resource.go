@@ -0,0 +1,185 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dnsimple/dnsimple-go/dnsimple"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &DomainSecondaryZonePrimaryResource{}
+	_ resource.ResourceWithConfigure = &DomainSecondaryZonePrimaryResource{}
+)
+
+func NewDomainSecondaryZonePrimaryResource() resource.Resource {
+	return &DomainSecondaryZonePrimaryResource{}
+}
+
+// DomainSecondaryZonePrimaryResource links a registered primary server to a secondary zone, completing
+// the secondary DNS lifecycle that DomainSecondaryZoneResource can't complete on its own.
+type DomainSecondaryZonePrimaryResource struct {
+	config *common.DnsimpleProviderConfig
+}
+
+// DomainSecondaryZonePrimaryResourceModel describes the resource data model.
+type DomainSecondaryZonePrimaryResourceModel struct {
+	ZoneName  types.String `tfsdk:"zone_name"`
+	PrimaryID types.Int64  `tfsdk:"primary_id"`
+	ID        types.Int64  `tfsdk:"id"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+func (r *DomainSecondaryZonePrimaryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_secondary_zone_primary"
+}
+
+func (r *DomainSecondaryZonePrimaryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Links a primary server to a DNSimple secondary zone",
+		Attributes: map[string]schema.Attribute{
+			"zone_name": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"primary_id": schema.Int64Attribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "When the primary server was linked to the zone, in ISO 8601 format.",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "When the link was last updated, in ISO 8601 format.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *DomainSecondaryZonePrimaryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*common.DnsimpleProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.DnsimpleProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.config = config
+}
+
+func (r *DomainSecondaryZonePrimaryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DomainSecondaryZonePrimaryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.config.Client.SecondaryDNS.LinkPrimaryServer(ctx, r.config.AccountID, data.ZoneName.ValueString(), data.PrimaryID.ValueInt64())
+	if err != nil {
+		var errorResponse *dnsimple.ErrorResponse
+		if errors.As(err, &errorResponse) {
+			resp.Diagnostics.Append(utils.AttributeErrorsToDiagnostics(errorResponse)...)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed to link primary server %d to DNSimple secondary zone %q", data.PrimaryID.ValueInt64(), data.ZoneName.ValueString()),
+			err.Error(),
+		)
+		return
+	}
+
+	r.updateModelFromAPIResponse(response.Data, data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainSecondaryZonePrimaryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DomainSecondaryZonePrimaryResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// DNSimple has no API to fetch a single zone/primary-server link, so we confirm it still exists by
+	// checking it's present among this zone's linked primaries specifically, not the account's whole
+	// primary server roster.
+	zoneName := data.ZoneName.ValueString()
+
+	linkedIDs, err := common.ListLinkedPrimaryServerIDs(ctx, r.config.Client, r.config.AccountID, zoneName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed to list primary servers linked to DNSimple secondary zone %q", zoneName),
+			err.Error(),
+		)
+		return
+	}
+
+	for _, id := range linkedIDs {
+		if id == data.PrimaryID.ValueInt64() {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	// The primary server is no longer linked to this zone; remove it from state.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *DomainSecondaryZonePrimaryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// No-op; zone_name and primary_id both require replacement, so there's nothing else to update.
+}
+
+func (r *DomainSecondaryZonePrimaryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *DomainSecondaryZonePrimaryResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.config.Client.SecondaryDNS.UnlinkPrimaryServer(ctx, r.config.AccountID, data.ZoneName.ValueString(), data.PrimaryID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed to unlink primary server %d from DNSimple secondary zone %q", data.PrimaryID.ValueInt64(), data.ZoneName.ValueString()),
+			err.Error(),
+		)
+	}
+}
+
+func (r *DomainSecondaryZonePrimaryResource) updateModelFromAPIResponse(link *dnsimple.SecondaryDNSPrimaryServerLink, data *DomainSecondaryZonePrimaryResourceModel) {
+	data.ID = types.Int64Value(link.ID)
+	data.CreatedAt = types.StringValue(link.CreatedAt)
+	data.UpdatedAt = types.StringValue(link.UpdatedAt)
+}
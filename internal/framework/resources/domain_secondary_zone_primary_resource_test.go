@@ -0,0 +1,198 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dnsimple/dnsimple-go/dnsimple"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+)
+
+func TestDomainSecondaryZonePrimaryResourceSchema(t *testing.T) {
+	ctx := context.Background()
+	schemaRequest := resource.SchemaRequest{}
+	schemaResponse := &resource.SchemaResponse{}
+
+	NewDomainSecondaryZonePrimaryResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+// newTestPrimaryLinkServer fakes the per-zone primary server link endpoints used by
+// DomainSecondaryZonePrimaryResource: linking, listing (used by Read to confirm a link still exists),
+// and unlinking.
+func newTestPrimaryLinkServer(t *testing.T, initialLinkedIDs []int64) (client *dnsimple.Client, unlinked *[]int64) {
+	t.Helper()
+
+	linkedIDs := append([]int64(nil), initialLinkedIDs...)
+	var unlinkedIDs []int64
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/1/zones/example.com/secondary_dns/primary_servers", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			fmt.Fprint(w, `{"data":{"id":9,"created_at":"2023-01-01T00:00:00Z","updated_at":"2023-01-01T00:00:00Z"}}`)
+			return
+		}
+
+		fmt.Fprint(w, `{"data":[`)
+		for i, id := range linkedIDs {
+			if i > 0 {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprintf(w, `{"id":%d}`, id)
+		}
+		fmt.Fprint(w, `]}`)
+	})
+	mux.HandleFunc("/v2/1/zones/example.com/secondary_dns/primary_servers/", func(w http.ResponseWriter, r *http.Request) {
+		var id int64
+		fmt.Sscanf(r.URL.Path, "/v2/1/zones/example.com/secondary_dns/primary_servers/%d", &id)
+		unlinkedIDs = append(unlinkedIDs, id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client = dnsimple.NewClient(http.DefaultClient)
+	client.BaseURL = server.URL
+
+	return client, &unlinkedIDs
+}
+
+func TestDomainSecondaryZonePrimaryResourceCreate(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newTestPrimaryLinkServer(t, nil)
+
+	r := &DomainSecondaryZonePrimaryResource{config: &common.DnsimpleProviderConfig{Client: client, AccountID: "1"}}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	model := DomainSecondaryZonePrimaryResourceModel{
+		ZoneName:  types.StringValue("example.com"),
+		PrimaryID: types.Int64Value(5),
+		ID:        types.Int64Unknown(),
+		CreatedAt: types.StringUnknown(),
+		UpdatedAt: types.StringUnknown(),
+	}
+	if diags := plan.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("plan.Set diagnostics: %+v", diags)
+	}
+
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+	}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create diagnostics: %+v", resp.Diagnostics)
+	}
+
+	var result DomainSecondaryZonePrimaryResourceModel
+	if diags := resp.State.Get(ctx, &result); diags.HasError() {
+		t.Fatalf("State.Get diagnostics: %+v", diags)
+	}
+
+	if result.ID.ValueInt64() != 9 {
+		t.Errorf("ID = %d, want 9", result.ID.ValueInt64())
+	}
+	if result.CreatedAt.ValueString() != "2023-01-01T00:00:00Z" {
+		t.Errorf("CreatedAt = %q, want the API-returned timestamp", result.CreatedAt.ValueString())
+	}
+}
+
+func TestDomainSecondaryZonePrimaryResourceReadRemovesStateWhenUnlinked(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		name          string
+		linkedIDs     []int64
+		wantRemaining bool
+	}{
+		{name: "still linked", linkedIDs: []int64{5, 7}, wantRemaining: true},
+		{name: "no longer linked", linkedIDs: []int64{7}, wantRemaining: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			client, _ := newTestPrimaryLinkServer(t, tc.linkedIDs)
+
+			r := &DomainSecondaryZonePrimaryResource{config: &common.DnsimpleProviderConfig{Client: client, AccountID: "1"}}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+			model := DomainSecondaryZonePrimaryResourceModel{
+				ZoneName:  types.StringValue("example.com"),
+				PrimaryID: types.Int64Value(5),
+				ID:        types.Int64Value(9),
+				CreatedAt: types.StringValue("2023-01-01T00:00:00Z"),
+				UpdatedAt: types.StringValue("2023-01-01T00:00:00Z"),
+			}
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+			if diags := state.Set(ctx, &model); diags.HasError() {
+				t.Fatalf("state.Set diagnostics: %+v", diags)
+			}
+
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read diagnostics: %+v", resp.Diagnostics)
+			}
+
+			if resp.State.Raw.IsNull() == tc.wantRemaining {
+				t.Errorf("state removed = %v, want remaining = %v", resp.State.Raw.IsNull(), tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestDomainSecondaryZonePrimaryResourceDelete(t *testing.T) {
+	ctx := context.Background()
+	client, unlinked := newTestPrimaryLinkServer(t, []int64{5})
+
+	r := &DomainSecondaryZonePrimaryResource{config: &common.DnsimpleProviderConfig{Client: client, AccountID: "1"}}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	model := DomainSecondaryZonePrimaryResourceModel{
+		ZoneName:  types.StringValue("example.com"),
+		PrimaryID: types.Int64Value(5),
+		ID:        types.Int64Value(9),
+		CreatedAt: types.StringValue("2023-01-01T00:00:00Z"),
+		UpdatedAt: types.StringValue("2023-01-01T00:00:00Z"),
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("state.Set diagnostics: %+v", diags)
+	}
+
+	resp := &resource.DeleteResponse{}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Delete diagnostics: %+v", resp.Diagnostics)
+	}
+
+	if len(*unlinked) != 1 || (*unlinked)[0] != 5 {
+		t.Errorf("unlinked IDs = %v, want [5]", *unlinked)
+	}
+}
@@ -0,0 +1,184 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-providers/terraform-provider-dnsimple/internal/framework/common"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &DomainZoneResource{}
+	_ resource.ResourceWithConfigure   = &DomainZoneResource{}
+	_ resource.ResourceWithImportState = &DomainZoneResource{}
+)
+
+func NewDomainZoneResource() resource.Resource {
+	return &DomainZoneResource{}
+}
+
+// DomainZoneResource manages the SOA record parameters of a primary zone. DNSimple creates the zone
+// itself implicitly when a domain is added to the account, so this resource doesn't create or delete
+// the zone, only the SOA settings on it.
+type DomainZoneResource struct {
+	config *common.DnsimpleProviderConfig
+}
+
+// DomainZoneResourceModel describes the resource data model.
+type DomainZoneResourceModel struct {
+	Name types.String `tfsdk:"name"`
+	ID   types.Int64  `tfsdk:"id"`
+	common.ZoneSOAModel
+}
+
+func (r *DomainZoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_zone"
+}
+
+func (r *DomainZoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Required: true,
+		},
+		"id": schema.Int64Attribute{
+			// For most of the APIs, domain name is the primary key exposed, rather than the ID
+			Computed: true,
+		},
+	}
+
+	for name, attribute := range common.ZoneSOASchemaAttributes() {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "DNSimple primary zone resource. Manages the SOA record parameters of a zone that already exists in the account; it does not create or delete the zone itself.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *DomainZoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*common.DnsimpleProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.DnsimpleProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.config = config
+}
+
+func (r *DomainZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DomainZoneResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneResponse, err := r.config.Client.Zones.GetZone(ctx, r.config.AccountID, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed to read DNSimple zone %q", data.Name.ValueString()),
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.Int64Value(zoneResponse.Data.ID)
+
+	if resp.Diagnostics.Append(r.reconcileSOA(ctx, data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DomainZoneResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneResponse, err := r.config.Client.Zones.GetZone(ctx, r.config.AccountID, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed to read DNSimple zone %q", data.Name.ValueString()),
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.Int64Value(zoneResponse.Data.ID)
+
+	soaRecord, err := common.FetchSOARecord(ctx, r.config.Client, r.config.AccountID, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed to read SOA record for DNSimple zone %q", data.Name.ValueString()),
+			err.Error(),
+		)
+		return
+	}
+
+	if err := common.ReadSOAIntoModel(soaRecord, &data.ZoneSOAModel); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed to parse SOA record for DNSimple zone %q", data.Name.ValueString()),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DomainZoneResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if resp.Diagnostics.Append(r.reconcileSOA(ctx, data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// reconcileSOA applies any SOA parameters set on data to the zone's SOA record, then reads the record
+// back so data reflects the values DNSimple actually stored (defaults included).
+func (r *DomainZoneResource) reconcileSOA(ctx context.Context, data *DomainZoneResourceModel) diag.Diagnostics {
+	return common.ReconcileZoneSOA(ctx, r.config.Client, r.config.AccountID, data.Name.ValueString(), &data.ZoneSOAModel)
+}
+
+func (r *DomainZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No-op; this resource only manages the SOA record of a zone created implicitly by adding a domain,
+	// and DNSimple has no API to delete the SOA record independently of the zone itself.
+}
+
+func (r *DomainZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}